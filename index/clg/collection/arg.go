@@ -131,19 +131,6 @@ func ArgToFeatureSet(args []interface{}, index int) (spec.FeatureSet, error) {
 	return nil, maskAnyf(wrongArgumentTypeError, "expected spec.FeatureSet got %T", args[index])
 }
 
-// ArgToFloat64 converts the argument under index to float64, if possible.
-func ArgToFloat64(args []interface{}, index int) (float64, error) {
-	if len(args) < index+1 {
-		return 0, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", index+1, len(args))
-	}
-
-	if f, ok := args[index].(float64); ok {
-		return f, nil
-	}
-
-	return 0, maskAnyf(wrongArgumentTypeError, "expected float64 got %T", args[index])
-}
-
 // ArgToFloat64Slice converts the argument under index to []float64, if
 // possible.
 func ArgToFloat64Slice(args []interface{}, index int, def ...[]float64) ([]float64, error) {
@@ -206,38 +193,6 @@ func ArgToFloat64SliceSlice(args []interface{}, index int, def ...[][]float64) (
 	return nil, maskAnyf(wrongArgumentTypeError, "expected [][]float64 got %T", args[index])
 }
 
-// ArgToInt converts the argument under index to int, if possible. Optionally
-// it takes one default value that is returned in case there is no argument
-// available for the given index.
-func ArgToInt(args []interface{}, index int, def ...int) (int, error) {
-	// In any case we should make sure the defaults are validated. This causes a
-	// more strict usage and understanding of the argument helper APIs.
-	if len(def) > 1 {
-		return 0, maskAnyf(tooManyArgumentsError, "expected 1 default got %d", len(def))
-	}
-
-	if len(args) < index+1 {
-		if len(def) == 1 {
-			// There is no argument given, thus we use the default.
-			return def[0], nil
-		}
-		return 0, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", index+1, len(args))
-	}
-
-	if _, ok := args[index].(DefaultArg); ok {
-		if len(def) < 1 {
-			return 0, maskAnyf(notEnoughArgumentsError, "expected 1 default got 0")
-		}
-		// There is no argument given, thus we use the default.
-		return def[0], nil
-	}
-
-	if i, ok := args[index].(int); ok {
-		return i, nil
-	}
-	return 0, maskAnyf(wrongArgumentTypeError, "expected int got %T", args[index])
-}
-
 // ArgToIntSlice converts the argument under index to []int, if possible.
 func ArgToIntSlice(args []interface{}, index int) ([]int, error) {
 	if len(args) < index+1 {
@@ -251,38 +206,6 @@ func ArgToIntSlice(args []interface{}, index int) ([]int, error) {
 	return nil, maskAnyf(wrongArgumentTypeError, "expected []int got %T", args[index])
 }
 
-// ArgToString converts the argument under index to string, if possible.
-// Optionally it takes one default value that is returned in case there is no
-// argument available for the given index.
-func ArgToString(args []interface{}, index int, def ...string) (string, error) {
-	// In any case we should make sure the defaults are validated. This causes a
-	// more strict usage and understanding of the argument helper APIs.
-	if len(def) > 1 {
-		return "", maskAnyf(tooManyArgumentsError, "expected 1 default got %d", len(def))
-	}
-
-	if len(args) < index+1 {
-		if len(def) == 1 {
-			// There is no argument given, thus we use the default.
-			return def[0], nil
-		}
-		return "", maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", index+1, len(args))
-	}
-
-	if _, ok := args[index].(DefaultArg); ok {
-		if len(def) < 1 {
-			return "", maskAnyf(notEnoughArgumentsError, "expected 1 default got 0")
-		}
-		// There is no argument given, thus we use the default.
-		return def[0], nil
-	}
-
-	if s, ok := args[index].(string); ok {
-		return s, nil
-	}
-	return "", maskAnyf(wrongArgumentTypeError, "expected string got %T", args[index])
-}
-
 // ArgToStringSlice converts the argument under index to []string, if possible.
 // Optionally it takes one default value that is returned in case there is no
 // argument available for the given index.
@@ -328,18 +251,5 @@ func ArgsToValues(args []interface{}) []reflect.Value {
 	return values
 }
 
-// ValuesToArgs converts the given []reflect.Value to []interface{}.
-func ValuesToArgs(values []reflect.Value) ([]interface{}, error) {
-	if len(values) > 2 {
-		return nil, maskAnyf(tooManyArgumentsError, "expected 2 got %d", len(values))
-	}
-	if len(values) < 2 {
-		return nil, maskAnyf(notEnoughArgumentsError, "expected 2 got %d", len(values))
-	}
-
-	if !values[1].IsValid() || values[1].IsNil() {
-		return values[0].Interface().([]interface{}), nil
-	}
-
-	return nil, maskAny(values[1].Interface().(error))
-}
+// ValuesToArgs and ValuesToArgsStrict live in result.go, next to the
+// ResultToX family of decoders they are meant to be used alongside.
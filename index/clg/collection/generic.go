@@ -0,0 +1,131 @@
+package collection
+
+import (
+	"math"
+	"reflect"
+)
+
+// ArgOptions configures the behaviour of ArgToWith. The zero value matches
+// the strict behaviour of ArgTo.
+type ArgOptions struct {
+	// ArgCoerceNumeric enables widening of numeric arguments the same way a
+	// type-checker widens untyped constants: an int argument is coerced to
+	// float64, and a whole-number float64 argument within int range is
+	// coerced to int. Without this flag numeric arguments must match T
+	// exactly, as with the existing ArgToX helpers.
+	ArgCoerceNumeric bool
+}
+
+// ArgTo converts the argument under index to T, if possible. Optionally it
+// takes one default value that is returned in case there is no argument
+// available for the given index. ArgTo implements the same contract as the
+// hand-rolled ArgToInt, ArgToFloat64, ArgToString, etc. via reflection, so
+// new call sites do not need a dedicated helper per Go type.
+func ArgTo[T any](args []interface{}, index int, def ...T) (T, error) {
+	return ArgToWith[T](args, index, ArgOptions{}, def...)
+}
+
+// ArgToWith behaves like ArgTo but accepts ArgOptions to opt into relaxed
+// behaviour, such as ArgCoerceNumeric.
+func ArgToWith[T any](args []interface{}, index int, opts ArgOptions, def ...T) (T, error) {
+	var zero T
+
+	// In any case we should make sure the defaults are validated. This causes
+	// a more strict usage and understanding of the argument helper APIs.
+	if len(def) > 1 {
+		return zero, maskAnyf(tooManyArgumentsError, "expected 1 default got %d", len(def))
+	}
+
+	if len(args) < index+1 {
+		if len(def) == 1 {
+			// There is no argument given, thus we use the default.
+			return def[0], nil
+		}
+		return zero, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", index+1, len(args))
+	}
+
+	if _, ok := args[index].(DefaultArg); ok {
+		if len(def) < 1 {
+			return zero, maskAnyf(notEnoughArgumentsError, "expected 1 default got 0")
+		}
+		// There is no argument given, thus we use the default.
+		return def[0], nil
+	}
+
+	if t, ok := args[index].(T); ok {
+		return t, nil
+	}
+
+	if opts.ArgCoerceNumeric {
+		if t, ok := coerceNumeric[T](args[index]); ok {
+			return t, nil
+		}
+	}
+
+	return zero, maskAnyf(wrongArgumentTypeError, "expected %T got %T", zero, args[index])
+}
+
+// coerceNumeric implements the numeric widening rules documented on
+// ArgOptions.ArgCoerceNumeric. It returns false when a is not numeric, or
+// when T is not a numeric type coerceNumeric knows how to widen to.
+func coerceNumeric[T any](a interface{}) (T, bool) {
+	var zero T
+
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Float64:
+		if i, ok := a.(int); ok {
+			if t, ok := interface{}(float64(i)).(T); ok {
+				return t, true
+			}
+		}
+	case reflect.Int:
+		if f, ok := a.(float64); ok {
+			if math.IsNaN(f) || math.Trunc(f) != f {
+				return zero, false
+			}
+			// math.MaxInt64 does not round-trip through float64, it rounds up
+			// to 2^63 (2^63 itself is exactly representable, so a naive f >
+			// math.MaxInt64 check lets f == 2^63 slip through and wrap on
+			// conversion). Compare against the exact power-of-two bound
+			// instead, and double check the conversion round-trips.
+			const maxInt64Float = 1 << 63
+			if f < -maxInt64Float || f >= maxInt64Float {
+				return zero, false
+			}
+			i := int(f)
+			if float64(i) != f {
+				return zero, false
+			}
+			if t, ok := interface{}(i).(T); ok {
+				return t, true
+			}
+		}
+	}
+
+	return zero, false
+}
+
+// ArgToInt converts the argument under index to int, if possible. Optionally
+// it takes one default value that is returned in case there is no argument
+// available for the given index.
+func ArgToInt(args []interface{}, index int, def ...int) (int, error) {
+	return ArgTo[int](args, index, def...)
+}
+
+// ArgToFloat64 converts the argument under index to float64, if possible.
+//
+// Note this intentionally changes one corner case versus the original
+// hand-rolled implementation: a DefaultArg{} at index, with no default
+// configured, now returns notEnoughArgumentsError instead of
+// wrongArgumentTypeError, matching every other ArgToX helper's DefaultArg
+// handling instead of falling through to a plain type assertion.
+func ArgToFloat64(args []interface{}, index int) (float64, error) {
+	return ArgTo[float64](args, index)
+}
+
+// ArgToString converts the argument under index to string, if possible.
+// Optionally it takes one default value that is returned in case there is no
+// argument available for the given index.
+func ArgToString(args []interface{}, index int, def ...string) (string, error) {
+	return ArgTo[string](args, index, def...)
+}
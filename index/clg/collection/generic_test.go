@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_ArgTo_Basic(t *testing.T) {
+	s, err := ArgTo[string]([]interface{}{"foo"}, 0)
+	if err != nil || s != "foo" {
+		t.Fatalf("got %v %v", s, err)
+	}
+
+	if _, err := ArgTo[string]([]interface{}{1}, 0); err == nil {
+		t.Fatal("expected wrongArgumentTypeError")
+	}
+
+	if _, err := ArgTo[string]([]interface{}{}, 0); err == nil {
+		t.Fatal("expected notEnoughArgumentsError")
+	}
+
+	s, err = ArgTo[string]([]interface{}{}, 0, "bar")
+	if err != nil || s != "bar" {
+		t.Fatalf("got %v %v", s, err)
+	}
+}
+
+func Test_ArgToWith_CoerceNumeric(t *testing.T) {
+	testCases := []struct {
+		name    string
+		arg     interface{}
+		target  string
+		wantErr bool
+	}{
+		{name: "int to float64", arg: 5, target: "float64"},
+		{name: "whole float64 to int", arg: 5.0, target: "int"},
+		{name: "fractional float64 to int rejected", arg: 5.5, target: "int", wantErr: true},
+		{name: "NaN to int rejected", arg: math.NaN(), target: "int", wantErr: true},
+		{name: "overflowing float64 to int rejected", arg: float64(math.MaxInt64), target: "int", wantErr: true},
+		{name: "min int64 float64 to int accepted", arg: float64(math.MinInt64), target: "int"},
+	}
+
+	for _, tc := range testCases {
+		var err error
+		switch tc.target {
+		case "float64":
+			var f float64
+			f, err = ArgToWith[float64]([]interface{}{tc.arg}, 0, ArgOptions{ArgCoerceNumeric: true})
+			if err == nil && f != float64(tc.arg.(int)) {
+				t.Fatalf("%s: got %v", tc.name, f)
+			}
+		case "int":
+			var i int
+			i, err = ArgToWith[int]([]interface{}{tc.arg}, 0, ArgOptions{ArgCoerceNumeric: true})
+			if err == nil && !tc.wantErr {
+				if float64(i) != tc.arg.(float64) {
+					t.Fatalf("%s: got %v", tc.name, i)
+				}
+			}
+		}
+
+		if tc.wantErr && err == nil {
+			t.Fatalf("%s: expected error, got none", tc.name)
+		}
+		if !tc.wantErr && err != nil {
+			t.Fatalf("%s: unexpected error: %#v", tc.name, err)
+		}
+	}
+}
+
+func Test_ArgToWith_CoerceNumeric_Disabled(t *testing.T) {
+	if _, err := ArgToWith[float64]([]interface{}{5}, 0, ArgOptions{}); err == nil {
+		t.Fatal("expected error when ArgCoerceNumeric is not set")
+	}
+}
+
+func Test_ArgToFloat64_DefaultArgWithoutDefault(t *testing.T) {
+	if _, err := ArgToFloat64([]interface{}{DefaultArg{}}, 0); err == nil {
+		t.Fatal("expected error substituting DefaultArg without a configured default")
+	}
+}
@@ -0,0 +1,67 @@
+package collection
+
+// ArgToRest converts every element of args from fromIndex onward to T, if
+// possible, and returns them as a typed slice. This removes the recurring
+// boilerplate of slicing args[n:] and type-asserting each element by hand,
+// and integrates cleanly with Signature and ValidateArgs for the fixed
+// prefix of arguments preceding the rest.
+//
+// ArgToRest returns notEnoughArgumentsError when fromIndex is greater than
+// len(args), and wrongArgumentTypeError, naming the offending index, on the
+// first element that does not assert to T. A single DefaultArg{} at
+// fromIndex is honoured as "empty rest", the same way a missing trailing
+// argument is, falling back to def if one was given.
+func ArgToRest[T any](args []interface{}, fromIndex int, def ...[]T) ([]T, error) {
+	// In any case we should make sure the defaults are validated. This causes a
+	// more strict usage and understanding of the argument helper APIs.
+	if len(def) > 1 {
+		return nil, maskAnyf(tooManyArgumentsError, "expected 1 default got %d", len(def))
+	}
+
+	if fromIndex > len(args) {
+		return nil, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", fromIndex, len(args))
+	}
+
+	if fromIndex < len(args) {
+		if _, ok := args[fromIndex].(DefaultArg); ok {
+			if len(def) == 1 {
+				// There is no argument given, thus we use the default.
+				return def[0], nil
+			}
+			return []T{}, nil
+		}
+	} else if len(def) == 1 {
+		// There is no argument given, thus we use the default.
+		return def[0], nil
+	}
+
+	rest := make([]T, 0, len(args)-fromIndex)
+	for i := fromIndex; i < len(args); i++ {
+		t, ok := args[i].(T)
+		if !ok {
+			var zero T
+			return nil, maskAnyf(wrongArgumentTypeError, "expected %T at index %d got %T", zero, i, args[i])
+		}
+		rest = append(rest, t)
+	}
+
+	return rest, nil
+}
+
+// ArgToRestString behaves like ArgToRest for string arguments. It exists for
+// call sites that predate Go generics support in this package.
+func ArgToRestString(args []interface{}, fromIndex int, def ...[]string) ([]string, error) {
+	return ArgToRest[string](args, fromIndex, def...)
+}
+
+// ArgToRestInt behaves like ArgToRest for int arguments. It exists for call
+// sites that predate Go generics support in this package.
+func ArgToRestInt(args []interface{}, fromIndex int, def ...[]int) ([]int, error) {
+	return ArgToRest[int](args, fromIndex, def...)
+}
+
+// ArgToRestFloat64 behaves like ArgToRest for float64 arguments. It exists
+// for call sites that predate Go generics support in this package.
+func ArgToRestFloat64(args []interface{}, fromIndex int, def ...[]float64) ([]float64, error) {
+	return ArgToRest[float64](args, fromIndex, def...)
+}
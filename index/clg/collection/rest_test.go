@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_ArgToRest(t *testing.T) {
+	rest, err := ArgToRest[string]([]interface{}{"a", "b", "c"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if !reflect.DeepEqual(rest, []string{"b", "c"}) {
+		t.Fatalf("unexpected result: %#v", rest)
+	}
+}
+
+func Test_ArgToRest_NotEnoughArguments(t *testing.T) {
+	if _, err := ArgToRest[string]([]interface{}{"a"}, 2); err == nil {
+		t.Fatal("expected notEnoughArgumentsError")
+	}
+}
+
+func Test_ArgToRest_WrongType(t *testing.T) {
+	if _, err := ArgToRest[string]([]interface{}{"a", 1}, 0); err == nil {
+		t.Fatal("expected wrongArgumentTypeError")
+	}
+}
+
+func Test_ArgToRest_EmptyRest(t *testing.T) {
+	rest, err := ArgToRest[string]([]interface{}{"a"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if len(rest) != 0 {
+		t.Fatalf("expected empty rest, got %#v", rest)
+	}
+}
+
+func Test_ArgToRest_DefaultArgEmptyRest(t *testing.T) {
+	rest, err := ArgToRest[string]([]interface{}{"a", DefaultArg{}}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if len(rest) != 0 {
+		t.Fatalf("expected empty rest, got %#v", rest)
+	}
+}
+
+func Test_ArgToRest_Default(t *testing.T) {
+	rest, err := ArgToRest[string]([]interface{}{"a"}, 1, []string{"x", "y"})
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if !reflect.DeepEqual(rest, []string{"x", "y"}) {
+		t.Fatalf("unexpected result: %#v", rest)
+	}
+}
+
+func Test_ArgToRest_NonGenericWrappers(t *testing.T) {
+	if rest, err := ArgToRestString([]interface{}{"a", "b"}, 1); err != nil || !reflect.DeepEqual(rest, []string{"b"}) {
+		t.Fatalf("ArgToRestString: got %#v %v", rest, err)
+	}
+	if rest, err := ArgToRestInt([]interface{}{"a", 1, 2}, 1); err != nil || !reflect.DeepEqual(rest, []int{1, 2}) {
+		t.Fatalf("ArgToRestInt: got %#v %v", rest, err)
+	}
+	if rest, err := ArgToRestFloat64([]interface{}{"a", 1.5, 2.5}, 1); err != nil || !reflect.DeepEqual(rest, []float64{1.5, 2.5}) {
+		t.Fatalf("ArgToRestFloat64: got %#v %v", rest, err)
+	}
+}
@@ -0,0 +1,99 @@
+package collection
+
+import (
+	"reflect"
+)
+
+// errorType is the reflect.Type of the error interface, used to recognise
+// the trailing error slot in ValuesToArgs without calling IsNil on a
+// non-nilable Kind.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ValuesToArgs converts the given []reflect.Value to []interface{}, the
+// form ResultToInt, ResultToString, etc. below operate on. When the last
+// value's type implements error, or it is an invalid (untyped nil) value, it
+// is treated as the error slot the same way CLG implementations return
+// their final error value, and is returned directly if it is non-nil.
+// Otherwise the last value is treated as ordinary result data. This
+// replaces the former 2-value-only contract, so CLGs returning arbitrary
+// arity results, e.g. (int, string, error) or ([]float64, error), can be
+// decoded the same way. Callers relying on the old exact-2 contract should
+// use ValuesToArgsStrict instead.
+func ValuesToArgs(values []reflect.Value) ([]interface{}, error) {
+	if len(values) < 1 {
+		return nil, maskAnyf(notEnoughArgumentsError, "expected at least 1 got %d", len(values))
+	}
+
+	errValue := values[len(values)-1]
+	if !errValue.IsValid() || errValue.Type().Implements(errorType) {
+		if errValue.IsValid() && !(isNilableKind(errValue.Kind()) && errValue.IsNil()) {
+			return nil, maskAny(errValue.Interface().(error))
+		}
+
+		args := make([]interface{}, len(values)-1)
+		for i, v := range values[:len(values)-1] {
+			args[i] = v.Interface()
+		}
+
+		return args, nil
+	}
+
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v.Interface()
+	}
+
+	return args, nil
+}
+
+// ValuesToArgsStrict preserves the historic ValuesToArgs contract of exactly
+// 2 values, the second being an error. It exists so call sites can migrate
+// to the variadic ValuesToArgs without having to do so in lockstep.
+func ValuesToArgsStrict(values []reflect.Value) ([]interface{}, error) {
+	if len(values) > 2 {
+		return nil, maskAnyf(tooManyArgumentsError, "expected 2 got %d", len(values))
+	}
+	if len(values) < 2 {
+		return nil, maskAnyf(notEnoughArgumentsError, "expected 2 got %d", len(values))
+	}
+
+	if !values[1].IsValid() || values[1].IsNil() {
+		return values[0].Interface().([]interface{}), nil
+	}
+
+	return nil, maskAny(values[1].Interface().(error))
+}
+
+// ResultToInt converts the result under index to int, if possible. It uses
+// the same error vocabulary as ArgTo, so chaining code can handle argument
+// and result decoding consistently.
+func ResultToInt(res []interface{}, index int) (int, error) {
+	return ArgTo[int](res, index)
+}
+
+// ResultToString converts the result under index to string, if possible.
+func ResultToString(res []interface{}, index int) (string, error) {
+	return ArgTo[string](res, index)
+}
+
+// ResultToFloat64 converts the result under index to float64, if possible.
+func ResultToFloat64(res []interface{}, index int) (float64, error) {
+	return ArgTo[float64](res, index)
+}
+
+// ResultToBool converts the result under index to bool, if possible.
+func ResultToBool(res []interface{}, index int) (bool, error) {
+	return ArgTo[bool](res, index)
+}
+
+// ResultToFloat64Slice converts the result under index to []float64, if
+// possible.
+func ResultToFloat64Slice(res []interface{}, index int) ([]float64, error) {
+	return ArgTo[[]float64](res, index)
+}
+
+// ResultToStringSlice converts the result under index to []string, if
+// possible.
+func ResultToStringSlice(res []interface{}, index int) ([]string, error) {
+	return ArgTo[[]string](res, index)
+}
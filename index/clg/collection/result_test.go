@@ -0,0 +1,99 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_ValuesToArgs(t *testing.T) {
+	someError := errors.New("test error")
+
+	testCases := []struct {
+		values  []reflect.Value
+		args    []interface{}
+		err     error
+		wantErr bool
+	}{
+		// Error only, no result values.
+		{
+			values:  []reflect.Value{reflect.ValueOf((*error)(nil)).Elem()},
+			args:    []interface{}{},
+			wantErr: false,
+		},
+		// Error only, error set.
+		{
+			values:  []reflect.Value{reflect.ValueOf(someError)},
+			wantErr: true,
+		},
+		// N results with a trailing nil error.
+		{
+			values:  []reflect.Value{reflect.ValueOf(1), reflect.ValueOf("foo"), reflect.ValueOf((*error)(nil)).Elem()},
+			args:    []interface{}{1, "foo"},
+			wantErr: false,
+		},
+		// N results with a trailing non-nil error.
+		{
+			values:  []reflect.Value{reflect.ValueOf(1), reflect.ValueOf("foo"), reflect.ValueOf(someError)},
+			wantErr: true,
+		},
+		// Last value does not implement error, so it is treated as data, not
+		// an error slot.
+		{
+			values:  []reflect.Value{reflect.ValueOf(1), reflect.ValueOf("foo")},
+			args:    []interface{}{1, "foo"},
+			wantErr: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		args, err := ValuesToArgs(tc.values)
+
+		if tc.wantErr && err == nil {
+			t.Fatalf("case %d: expected error, got none", i)
+		}
+		if !tc.wantErr {
+			if err != nil {
+				t.Fatalf("case %d: unexpected error: %#v", i, err)
+			}
+			if !reflect.DeepEqual(args, tc.args) {
+				t.Fatalf("case %d: expected %#v got %#v", i, tc.args, args)
+			}
+		}
+	}
+}
+
+func Test_ValuesToArgsStrict(t *testing.T) {
+	_, err := ValuesToArgsStrict([]reflect.Value{reflect.ValueOf([]interface{}{"a"})})
+	if err == nil {
+		t.Fatal("expected error for too few values")
+	}
+
+	_, err = ValuesToArgsStrict([]reflect.Value{reflect.ValueOf([]interface{}{"a"}), reflect.ValueOf("b"), reflect.ValueOf("c")})
+	if err == nil {
+		t.Fatal("expected error for too many values")
+	}
+}
+
+func Test_ResultToX(t *testing.T) {
+	res := []interface{}{42, "foo", 3.14, []float64{1, 2}, []string{"a", "b"}, true}
+
+	if i, err := ResultToInt(res, 0); err != nil || i != 42 {
+		t.Fatalf("ResultToInt: got %v %v", i, err)
+	}
+	if s, err := ResultToString(res, 1); err != nil || s != "foo" {
+		t.Fatalf("ResultToString: got %v %v", s, err)
+	}
+	if f, err := ResultToFloat64(res, 2); err != nil || f != 3.14 {
+		t.Fatalf("ResultToFloat64: got %v %v", f, err)
+	}
+	if fs, err := ResultToFloat64Slice(res, 3); err != nil || len(fs) != 2 {
+		t.Fatalf("ResultToFloat64Slice: got %v %v", fs, err)
+	}
+	if ss, err := ResultToStringSlice(res, 4); err != nil || len(ss) != 2 {
+		t.Fatalf("ResultToStringSlice: got %v %v", ss, err)
+	}
+	if b, err := ResultToBool(res, 5); err != nil || !b {
+		t.Fatalf("ResultToBool: got %v %v", b, err)
+	}
+}
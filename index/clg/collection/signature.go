@@ -0,0 +1,145 @@
+package collection
+
+import (
+	"reflect"
+)
+
+// ArgSpec describes a single parameter of a Signature. Type is matched
+// against the actual argument using reflect's assignability rules, so Type
+// may be a concrete reflect.Type (e.g. reflect.TypeOf(0)) or an interface
+// type obtained from one of the spec.* interfaces (e.g.
+// reflect.TypeOf((*spec.Distribution)(nil)).Elem()).
+type ArgSpec struct {
+	// Type is the expected type of the argument under the position this
+	// ArgSpec occupies within a Signature.
+	Type reflect.Type
+	// Optional indicates the argument may be omitted, or be a DefaultArg{}
+	// placeholder, in which case Default is used instead.
+	Optional bool
+	// Default is substituted for the argument when it is missing or a
+	// DefaultArg{} placeholder. Default is only used when Optional is true.
+	Default interface{}
+}
+
+// Signature describes the arguments a CLG implementation expects, in order.
+// It is consumed by ValidateArgs to validate and normalise an argument list
+// in a single call, instead of requiring one ArgToX call per parameter.
+type Signature []ArgSpec
+
+// argGetter abstracts away where argument values actually come from. This
+// allows ValidateArgs to treat a plain positional argument list and an
+// unpacked nested argument list the same way, and leaves room for further
+// sources, like channels or lazy evaluators, to be plugged in later.
+type argGetter interface {
+	// get returns the argument at position i, or an error if i is out of
+	// bounds.
+	get(i int) (interface{}, error)
+	// len returns the number of arguments available.
+	len() int
+}
+
+// sliceArgGetter is the only argGetter implementation so far, backed
+// directly by a []interface{}. newArgGetter decides which underlying slice
+// it wraps, a plain positional list or an unpacked one; further sources,
+// like channels or lazy evaluators, would get their own argGetter
+// implementation instead of reusing this one.
+type sliceArgGetter struct {
+	args []interface{}
+}
+
+func (g sliceArgGetter) len() int {
+	return len(g.args)
+}
+
+func (g sliceArgGetter) get(i int) (interface{}, error) {
+	if i < 0 || i >= len(g.args) {
+		return nil, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", i+1, len(g.args))
+	}
+	return g.args[i], nil
+}
+
+// newArgGetter returns the argGetter appropriate for args. This implements
+// the "builtin(f())" idea known from Go's own argument handling: when args
+// has exactly one element and that element is itself a []interface{}, it is
+// treated as a chained call whose result is unpacked into positional
+// arguments. Otherwise args is used as given.
+func newArgGetter(args []interface{}) argGetter {
+	if len(args) == 1 {
+		if inner, ok := args[0].([]interface{}); ok {
+			return sliceArgGetter{args: inner}
+		}
+	}
+
+	return sliceArgGetter{args: args}
+}
+
+// ValidateArgs validates args against sig in a single call. It checks arity,
+// type-checks every slot against its ArgSpec, substitutes DefaultArg{}
+// placeholders and missing optional arguments with their configured
+// defaults, and returns the normalised argument slice. The returned slice is
+// ready to be passed through ArgsToValues into reflect.Value.Call.
+//
+// When args has length 1 and its single element is itself a []interface{},
+// that slice is transparently unpacked into positional arguments before
+// being matched against sig. This lets CLGs be chained as clgA(clgB())
+// without the caller writing explicit spread logic.
+func ValidateArgs(args []interface{}, sig Signature) ([]interface{}, error) {
+	g := newArgGetter(args)
+
+	out := make([]interface{}, len(sig))
+
+	for i, s := range sig {
+		if i >= g.len() {
+			if !s.Optional {
+				return nil, maskAnyf(notEnoughArgumentsError, "expected %d args(s) got %d", i+1, g.len())
+			}
+			out[i] = s.Default
+			continue
+		}
+
+		a, err := g.get(i)
+		if err != nil {
+			return nil, maskAny(err)
+		}
+
+		if _, ok := a.(DefaultArg); ok {
+			if !s.Optional {
+				return nil, maskAnyf(notEnoughArgumentsError, "expected 1 default got 0")
+			}
+			out[i] = s.Default
+			continue
+		}
+
+		if a == nil {
+			if s.Type != nil && !isNilableKind(s.Type.Kind()) {
+				return nil, maskAnyf(wrongArgumentTypeError, "expected %s got nil", s.Type)
+			}
+			out[i] = a
+			continue
+		}
+
+		if s.Type != nil && !reflect.TypeOf(a).AssignableTo(s.Type) {
+			return nil, maskAnyf(wrongArgumentTypeError, "expected %s got %T", s.Type, a)
+		}
+		out[i] = a
+	}
+
+	if g.len() > len(sig) {
+		return nil, maskAnyf(tooManyArgumentsError, "expected %d args(s) got %d", len(sig), g.len())
+	}
+
+	return out, nil
+}
+
+// isNilableKind reports whether a value of the given Kind can legally be
+// nil. It is used to decide whether a literal nil argument satisfies an
+// ArgSpec, since reflect.TypeOf(nil) returns a nil reflect.Type that cannot
+// be passed to AssignableTo.
+func isNilableKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
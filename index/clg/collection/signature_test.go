@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_ValidateArgs_ArityMismatch(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf("")},
+		{Type: reflect.TypeOf(0)},
+	}
+
+	if _, err := ValidateArgs([]interface{}{"foo"}, sig); err == nil {
+		t.Fatal("expected error for too few arguments")
+	}
+
+	if _, err := ValidateArgs([]interface{}{"foo", 1, "bar"}, sig); err == nil {
+		t.Fatal("expected error for too many arguments")
+	}
+}
+
+func Test_ValidateArgs_TypeMismatch(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf(0)},
+	}
+
+	if _, err := ValidateArgs([]interface{}{"foo"}, sig); err == nil {
+		t.Fatal("expected error for wrong argument type")
+	}
+}
+
+func Test_ValidateArgs_DefaultArgWithoutDefault(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf(0)},
+	}
+
+	if _, err := ValidateArgs([]interface{}{DefaultArg{}}, sig); err == nil {
+		t.Fatal("expected error substituting DefaultArg without a configured default")
+	}
+}
+
+func Test_ValidateArgs_DefaultArgWithDefault(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf(0), Optional: true, Default: 42},
+	}
+
+	out, err := ValidateArgs([]interface{}{DefaultArg{}}, sig)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if out[0] != 42 {
+		t.Fatalf("expected 42 got %v", out[0])
+	}
+}
+
+func Test_ValidateArgs_Unpack(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf("")},
+		{Type: reflect.TypeOf(0)},
+	}
+
+	inner := []interface{}{"foo", 3}
+	out, err := ValidateArgs([]interface{}{inner}, sig)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if out[0] != "foo" || out[1] != 3 {
+		t.Fatalf("unexpected result: %#v", out)
+	}
+}
+
+func Test_ValidateArgs_UnpackWithLeftover(t *testing.T) {
+	sig := Signature{
+		{Type: reflect.TypeOf("")},
+	}
+
+	inner := []interface{}{"foo", 3}
+	if _, err := ValidateArgs([]interface{}{inner}, sig); err == nil {
+		t.Fatal("expected error for leftover unpacked values")
+	}
+}
+
+func Test_ValidateArgs_NilArgument(t *testing.T) {
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+
+	// nil is legal for an interface-typed spec.
+	sig := Signature{
+		{Type: errType},
+	}
+	out, err := ValidateArgs([]interface{}{nil}, sig)
+	if err != nil {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if out[0] != nil {
+		t.Fatalf("expected nil got %v", out[0])
+	}
+
+	// nil is illegal for a non-nilable spec, like int.
+	sig = Signature{
+		{Type: reflect.TypeOf(0)},
+	}
+	if _, err := ValidateArgs([]interface{}{nil}, sig); err == nil {
+		t.Fatal("expected error for nil against a non-nilable type")
+	}
+}